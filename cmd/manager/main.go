@@ -0,0 +1,63 @@
+// Command manager is the entry point for the Jaeger operator: it builds the
+// controller-runtime manager, registers the Jaeger controller and the
+// validating admission webhook, and blocks until the manager is stopped.
+package main
+
+import (
+	"flag"
+	"os"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/controller"
+	"github.com/jaegertracing/jaeger-operator/pkg/webhook"
+)
+
+var log = logf.Log.WithName("cmd")
+
+func main() {
+	var metricsAddr string
+	flag.StringVar(&metricsAddr, "metrics-addr", ":8383", "The address the metric endpoint binds to")
+	flag.Parse()
+
+	logf.SetLogger(zap.New())
+
+	cfg, err := config.GetConfig()
+	if err != nil {
+		log.Error(err, "Failed to get the Kubernetes config")
+		os.Exit(1)
+	}
+
+	mgr, err := manager.New(cfg, manager.Options{MetricsBindAddress: metricsAddr})
+	if err != nil {
+		log.Error(err, "Failed to create the manager")
+		os.Exit(1)
+	}
+
+	if err := v1.AddToScheme(mgr.GetScheme()); err != nil {
+		log.Error(err, "Failed to register the Jaeger types with the manager's scheme")
+		os.Exit(1)
+	}
+
+	if err := controller.AddToManager(mgr); err != nil {
+		log.Error(err, "Failed to register the controllers with the manager")
+		os.Exit(1)
+	}
+
+	// admission review requests for the ValidatingWebhookConfiguration
+	// (deploy/webhook/validating-webhook-configuration.yaml) are only ever
+	// served if the handler is registered on the manager's webhook server
+	webhook.RegisterWithManager(mgr)
+
+	log.Info("Starting the manager")
+	if err := mgr.Start(signals.SetupSignalHandler()); err != nil {
+		log.Error(err, "Manager exited non-zero")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,52 @@
+// Package storage knows how to provision and recognize the storage backends
+// that the operator supports.
+package storage
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+// validTypes lists every storage type the operator can provision or talk to.
+// Keep this in sync with what strategy.normalize's distribution-specific
+// helpers (elasticsearch/opensearch, grpc-plugin, ...) know how to handle
+var validTypes = []string{
+	"memory",
+	"badger",
+	"cassandra",
+	"elasticsearch",
+	"opensearch",
+	"kafka",
+	"grpc-plugin",
+}
+
+// ValidTypes returns the list of storage types known to the operator
+func ValidTypes() []string {
+	return validTypes
+}
+
+// ShouldDeployElasticsearch returns true when the operator should provision a
+// managed Elasticsearch/OpenSearch cluster for the given storage spec, as
+// opposed to the user pointing at an already-running cluster
+func ShouldDeployElasticsearch(spec v1.JaegerStorageSpec) bool {
+	if !strings.EqualFold(spec.Type, "elasticsearch") && !strings.EqualFold(spec.Type, "opensearch") {
+		return false
+	}
+	return spec.Elasticsearch.DoNotProvision == false
+}
+
+// ElasticsearchDeployment knows how to build the Kubernetes objects for a
+// managed Elasticsearch/OpenSearch cluster backing a Jaeger instance
+type ElasticsearchDeployment struct {
+	Jaeger     *v1.Jaeger
+	CertScript string
+	Secrets    []corev1.Secret
+	Archive    bool
+
+	// Kind is the Kubernetes Kind of the custom resource to provision for this
+	// distribution: "Elasticsearch" or "OpenSearchCluster"
+	Kind string
+}
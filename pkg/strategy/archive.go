@@ -0,0 +1,106 @@
+package strategy
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/cronjob"
+	"github.com/jaegertracing/jaeger-operator/pkg/storage"
+)
+
+// archiveStorageEnabled returns true when the user has declared a dedicated
+// archive storage for this Jaeger instance
+func archiveStorageEnabled(jaeger *v1.Jaeger) bool {
+	return jaeger.Spec.Storage.Archive != nil && jaeger.Spec.Storage.Archive.Type != ""
+}
+
+// normalizeArchiveStorage applies the same defaulting rules used for the primary
+// storage to the archive storage, and falls back to the primary storage's type
+// whenever the user only configured options but not an explicit type
+func normalizeArchiveStorage(spec *v1.JaegerStorageSpec) {
+	if spec.Archive == nil {
+		return
+	}
+
+	if spec.Archive.Type == "" {
+		spec.Archive.Type = spec.Type
+	}
+
+	if unknownStorage(spec.Archive.Type) {
+		spec.Archive.Type = spec.Type
+	}
+
+	if isElasticsearchFamily(spec.Archive.Type) {
+		normalizeElasticsearchFor(&spec.Archive.Elasticsearch, spec.Archive.Type)
+		normalizeIndexCleaner(&spec.Archive.EsIndexCleaner, spec.Archive.Type)
+		normalizeRollover(&spec.Archive.EsRollover)
+	}
+}
+
+// newArchiveElasticsearchDeployment returns the ElasticsearchDeployment that backs the
+// archive storage: a dedicated managed cluster whenever the archive storage is of the
+// elasticsearch/opensearch family and not marked doNotProvision, and nil otherwise.
+// The archive's own options are not consulted when making this choice
+func newArchiveElasticsearchDeployment(jaeger *v1.Jaeger, secrets []corev1.Secret) *storage.ElasticsearchDeployment {
+	if !archiveStorageEnabled(jaeger) || !storage.ShouldDeployElasticsearch(*jaeger.Spec.Storage.Archive) {
+		return nil
+	}
+
+	return &storage.ElasticsearchDeployment{
+		Jaeger:     jaeger,
+		CertScript: esCertGenerationScript,
+		Secrets:    secrets,
+		Archive:    true,
+		Kind:       esManagedClusterKind(jaeger.Spec.Storage.Archive.Type),
+	}
+}
+
+// archiveIndexCleanerCronJobs returns the index-cleaner/rollover cronjobs that
+// target the archive indices, when archive storage is backed by elasticsearch
+func archiveIndexCleanerCronJobs(jaeger *v1.Jaeger) []cronjob.CronJob {
+	if !archiveStorageEnabled(jaeger) || !isElasticsearchFamily(jaeger.Spec.Storage.Archive.Type) {
+		return nil
+	}
+
+	jobs := []cronjob.CronJob{}
+	if jaeger.Spec.Storage.Archive.EsIndexCleaner.Enabled != nil && *jaeger.Spec.Storage.Archive.EsIndexCleaner.Enabled {
+		jobs = append(jobs, cronjob.CreateEsIndexCleanerArchive(jaeger))
+	}
+	if jaeger.Spec.Storage.Archive.EsRollover.Schedule != "" {
+		jobs = append(jobs, cronjob.CreateEsRolloverArchive(jaeger))
+	}
+
+	return jobs
+}
+
+// archiveStorageArgs returns the --es-archive.*/--cassandra-archive.* flags that
+// tell the collector and query deployments about the archive storage
+func archiveStorageArgs(jaeger *v1.Jaeger) []string {
+	if !archiveStorageEnabled(jaeger) {
+		return nil
+	}
+
+	prefix := "--es-archive"
+	if strings.EqualFold(jaeger.Spec.Storage.Archive.Type, "cassandra") {
+		prefix = "--cassandra-archive"
+	}
+
+	args := []string{fmt.Sprintf("%s.enabled=true", prefix)}
+	for flag, value := range jaeger.Spec.Storage.Archive.Options.Map() {
+		args = append(args, fmt.Sprintf("%s.%s=%s", prefix, flag, value))
+	}
+
+	return args
+}
+
+// normalizeArchiveFlags propagates the archive storage flags built by
+// archiveStorageArgs to the collector and query deployments, the two
+// components that serve/ingest archived traces
+func normalizeArchiveFlags(jaeger *v1.Jaeger) {
+	args := archiveStorageArgs(jaeger)
+	jaeger.Spec.Collector.Options = mergeFlags(jaeger.Spec.Collector.Options, args)
+	jaeger.Spec.Query.Options = mergeFlags(jaeger.Spec.Query.Options, args)
+}
@@ -0,0 +1,74 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestArchiveStorageArgsElasticsearchPrefix(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Storage.Archive = &v1.JaegerStorageSpec{Type: "elasticsearch"}
+
+	args := archiveStorageArgs(jaeger)
+
+	assert.Contains(t, args, "--es-archive.enabled=true")
+}
+
+func TestArchiveStorageArgsCassandraPrefix(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Storage.Archive = &v1.JaegerStorageSpec{Type: "cassandra"}
+
+	args := archiveStorageArgs(jaeger)
+
+	assert.Contains(t, args, "--cassandra-archive.enabled=true")
+}
+
+func TestArchiveStorageArgsDisabled(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+
+	args := archiveStorageArgs(jaeger)
+
+	assert.Nil(t, args)
+}
+
+func TestNormalizeArchiveStorageFallsBackToPrimaryType(t *testing.T) {
+	spec := &v1.JaegerStorageSpec{
+		Type:    "elasticsearch",
+		Archive: &v1.JaegerStorageSpec{},
+	}
+
+	normalizeArchiveStorage(spec)
+
+	assert.Equal(t, "elasticsearch", spec.Archive.Type)
+}
+
+func TestNormalizeArchiveStorageFallsBackOnUnknownType(t *testing.T) {
+	spec := &v1.JaegerStorageSpec{
+		Type:    "cassandra",
+		Archive: &v1.JaegerStorageSpec{Type: "not-a-real-storage"},
+	}
+
+	normalizeArchiveStorage(spec)
+
+	assert.Equal(t, "cassandra", spec.Archive.Type)
+}
+
+func TestNormalizeArchiveStorageKeepsExplicitType(t *testing.T) {
+	spec := &v1.JaegerStorageSpec{
+		Type:    "elasticsearch",
+		Archive: &v1.JaegerStorageSpec{Type: "cassandra"},
+	}
+
+	normalizeArchiveStorage(spec)
+
+	assert.Equal(t, "cassandra", spec.Archive.Type)
+}
+
+func TestNormalizeArchiveStorageNoArchive(t *testing.T) {
+	spec := &v1.JaegerStorageSpec{Type: "elasticsearch"}
+
+	assert.NotPanics(t, func() { normalizeArchiveStorage(spec) })
+}
@@ -2,11 +2,8 @@ package strategy
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"go.opentelemetry.io/otel/global"
 	corev1 "k8s.io/api/core/v1"
@@ -39,7 +36,10 @@ func For(ctx context.Context, jaeger *v1.Jaeger, secrets []corev1.Secret) S {
 		jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
 	}
 
-	normalize(ctx, jaeger)
+	result := normalize(ctx, jaeger)
+	for _, w := range result.Warnings {
+		jaeger.Logger().WithField("field", w.Field).Info(w.Message)
+	}
 
 	jaeger.Logger().WithField("strategy", jaeger.Spec.Strategy).Debug("Strategy chosen")
 	if jaeger.Spec.Strategy == v1.DeploymentStrategyAllInOne {
@@ -50,34 +50,45 @@ func For(ctx context.Context, jaeger *v1.Jaeger, secrets []corev1.Secret) S {
 		return newStreamingStrategy(ctx, jaeger)
 	}
 
-	es := &storage.ElasticsearchDeployment{Jaeger: jaeger, CertScript: esCertGenerationScript, Secrets: secrets}
-	return newProductionStrategy(ctx, jaeger, es)
+	es := &storage.ElasticsearchDeployment{
+		Jaeger:     jaeger,
+		CertScript: esCertGenerationScript,
+		Secrets:    secrets,
+		Kind:       esManagedClusterKind(jaeger.Spec.Storage.Type),
+	}
+	archiveEs := newArchiveElasticsearchDeployment(jaeger, secrets)
+	cronJobs := append(tenantCronJobs(jaeger), archiveIndexCleanerCronJobs(jaeger)...)
+	return newProductionStrategy(ctx, jaeger, es, archiveEs, cronJobs)
 }
 
 // normalize changes the incoming Jaeger object so that the defaults are applied when
-// needed and incompatible options are cleaned
-func normalize(ctx context.Context, jaeger *v1.Jaeger) {
+// needed and incompatible options are cleaned. It returns a ValidationResult recording
+// the fallbacks applied to the name, the storage type and the strategy (Warnings) and
+// every combination that the admission webhook should reject outright (Errors).
+// Fallbacks applied by the tenancy, archive storage and UI helpers are logged or
+// applied silently and are not recorded in the result
+func normalize(ctx context.Context, jaeger *v1.Jaeger) *ValidationResult {
 	tracer := global.TraceProvider().GetTracer(v1.ReconciliationTracer)
 	ctx, span := tracer.Start(ctx, "normalize")
 	defer span.End()
 
+	result := &ValidationResult{}
+	validateStorageAndStrategy(jaeger, result)
+
 	// we need a name!
 	if jaeger.Name == "" {
-		jaeger.Logger().Info("This Jaeger instance was created without a name. Applying a default name.")
+		result.AddWarning("metadata.name", "This Jaeger instance was created without a name. Applying a default name.")
 		jaeger.Name = "my-jaeger"
 	}
 
 	// normalize the storage type
 	if jaeger.Spec.Storage.Type == "" {
-		jaeger.Logger().Info("Storage type not provided. Falling back to 'memory'")
+		result.AddWarning("spec.storage.type", "Storage type not provided. Falling back to 'memory'")
 		jaeger.Spec.Storage.Type = "memory"
 	}
 
 	if unknownStorage(jaeger.Spec.Storage.Type) {
-		jaeger.Logger().WithFields(log.Fields{
-			"storage":       jaeger.Spec.Storage.Type,
-			"known-options": storage.ValidTypes(),
-		}).Info("The provided storage type is unknown. Falling back to 'memory'")
+		result.AddWarning("spec.storage.type", "The provided storage type %q is unknown, known options: %v. Falling back to 'memory'", jaeger.Spec.Storage.Type, storage.ValidTypes())
 		jaeger.Spec.Storage.Type = "memory"
 	}
 
@@ -89,7 +100,7 @@ func normalize(ctx context.Context, jaeger *v1.Jaeger) {
 	// check for incompatible options
 	// if the storage is `memory`, then the only possible strategy is `all-in-one`
 	if !distributedStorage(jaeger.Spec.Storage.Type) && jaeger.Spec.Strategy != v1.DeploymentStrategyAllInOne {
-		jaeger.Logger().WithField("storage", jaeger.Spec.Storage.Type).Warn("No suitable storage provided. Falling back to allInOne")
+		result.AddWarning("spec.strategy", "No suitable storage provided for strategy %q. Falling back to allInOne", jaeger.Spec.Strategy)
 		jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
 	}
 
@@ -104,11 +115,23 @@ func normalize(ctx context.Context, jaeger *v1.Jaeger) {
 	}
 
 	// note that the order normalization matters - UI norm expects all normalized properties
-	normalizeSparkDependencies(&jaeger.Spec.Storage)
-	normalizeIndexCleaner(&jaeger.Spec.Storage.EsIndexCleaner, jaeger.Spec.Storage.Type)
-	normalizeElasticsearch(&jaeger.Spec.Storage.Elasticsearch)
-	normalizeRollover(&jaeger.Spec.Storage.EsRollover)
+	// the gRPC storage plugin has no index cleaner, rollover or spark-dependencies concept
+	if !isGRPCPluginStorage(jaeger.Spec.Storage.Type) {
+		normalizeSparkDependencies(&jaeger.Spec.Storage)
+		normalizeIndexCleaner(&jaeger.Spec.Storage.EsIndexCleaner, jaeger.Spec.Storage.Type)
+		normalizeElasticsearchFor(&jaeger.Spec.Storage.Elasticsearch, jaeger.Spec.Storage.Type)
+		normalizeRollover(&jaeger.Spec.Storage.EsRollover)
+		if versionFlag := esVersionFlag(jaeger.Spec.Storage.Type); versionFlag != "" {
+			jaeger.Spec.Storage.Options = mergeFlags(jaeger.Spec.Storage.Options, []string{versionFlag})
+		}
+	}
+	normalizeArchiveStorage(&jaeger.Spec.Storage)
+	normalizeArchiveFlags(jaeger)
+	normalizeGRPCPlugin(jaeger)
+	normalizeTenancy(jaeger)
 	normalizeUI(&jaeger.Spec)
+
+	return result
 }
 
 func distributedStorage(storage string) bool {
@@ -142,7 +165,7 @@ func normalizeSparkDependencies(spec *v1.JaegerStorageSpec) {
 
 func normalizeIndexCleaner(spec *v1.JaegerEsIndexCleanerSpec, storage string) {
 	// auto enable only for supported storages
-	if storage == "elasticsearch" && spec.Enabled == nil {
+	if isElasticsearchFamily(storage) && spec.Enabled == nil {
 		trueVar := true
 		spec.Enabled = &trueVar
 	}
@@ -157,15 +180,22 @@ func normalizeIndexCleaner(spec *v1.JaegerEsIndexCleanerSpec, storage string) {
 }
 
 func normalizeElasticsearch(spec *v1.ElasticsearchSpec) {
+	normalizeElasticsearchFor(spec, "elasticsearch")
+}
+
+// normalizeElasticsearchFor applies Elasticsearch defaults, branching on the
+// distribution (elasticsearch or opensearch) for the bits that differ between
+// the two, such as the managed-cluster image, the CR kind and the redundancy
+// policy naming
+func normalizeElasticsearchFor(spec *v1.ElasticsearchSpec, storageType string) {
 	if spec.NodeCount == 0 {
 		spec.NodeCount = 3
 	}
 	if spec.RedundancyPolicy == "" {
-		if spec.NodeCount == 1 {
-			spec.RedundancyPolicy = esv1.ZeroRedundancy
-		} else {
-			spec.RedundancyPolicy = esv1.SingleRedundancy
-		}
+		spec.RedundancyPolicy = esRedundancyPolicyFor(storageType, spec.NodeCount)
+	}
+	if spec.Image == "" {
+		spec.Image = util.ImageName(spec.Image, esManagedClusterImageKey(storageType))
 	}
 	if spec.Resources == nil {
 		spec.Resources = &corev1.ResourceRequirements{
@@ -194,7 +224,7 @@ func normalizeUI(spec *v1.JaegerSpec) {
 			uiOpts = m
 		}
 	}
-	enableArchiveButton(uiOpts, spec.Storage.Options.Map())
+	enableArchiveButton(uiOpts, spec.Storage.Options.Map(), spec.Storage.Archive)
 	disableDependenciesTab(uiOpts, spec.Storage.Type, spec.Storage.Dependencies.Enabled)
 	enableLogOut(uiOpts, spec)
 	if len(uiOpts) > 0 {
@@ -202,12 +232,13 @@ func normalizeUI(spec *v1.JaegerSpec) {
 	}
 }
 
-func enableArchiveButton(uiOpts map[string]interface{}, sOpts map[string]string) {
+func enableArchiveButton(uiOpts map[string]interface{}, sOpts map[string]string, archive *v1.JaegerStorageSpec) {
 	// respect explicit settings
 	if _, ok := uiOpts["archiveEnabled"]; !ok {
 		// archive tab is by default disabled
 		if strings.EqualFold(sOpts["es-archive.enabled"], "true") ||
-			strings.EqualFold(sOpts["cassandra-archive.enabled"], "true") {
+			strings.EqualFold(sOpts["cassandra-archive.enabled"], "true") ||
+			(archive != nil && archive.Type != "") {
 			uiOpts["archiveEnabled"] = true
 		}
 	}
@@ -234,42 +265,6 @@ func disableDependenciesTab(uiOpts map[string]interface{}, storage string, depsE
 	}
 }
 
-func enableLogOut(uiOpts map[string]interface{}, spec *v1.JaegerSpec) {
-	if (spec.Ingress.Enabled != nil && *spec.Ingress.Enabled == false) ||
-		spec.Ingress.Security != v1.IngressSecurityOAuthProxy {
-		return
-	}
-
-	if _, ok := uiOpts["menu"]; ok {
-		return
-	}
-
-	docURL := viper.GetString("documentation-url")
-
-	menuStr := fmt.Sprintf(`[
-		{
-		  "label": "About",
-		  "items": [
-			{
-			  "label": "Documentation",
-			  "url": "%s"
-			}
-		  ]
-		},
-		{
-		  "label": "Log Out",
-		  "url": "/oauth/sign_in",
-		  "anchorTarget": "_self"
-		}
-	  ]`, docURL)
-
-	menuArray := make([]interface{}, 2)
-
-	json.Unmarshal([]byte(menuStr), &menuArray)
-
-	uiOpts["menu"] = menuArray
-}
-
 func unknownStorage(typ string) bool {
 	for _, k := range storage.ValidTypes() {
 		if strings.EqualFold(typ, k) {
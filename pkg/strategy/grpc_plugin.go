@@ -0,0 +1,119 @@
+package strategy
+
+import (
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+const (
+	storageTypeGRPCPlugin = "grpc-plugin"
+
+	// grpcPluginVolumeName/grpcPluginInitContainerName name the emptyDir volume
+	// and init container that copy the plugin binary out of the user-supplied
+	// plugin image, so the collector/query containers can exec it at the path
+	// given in spec.Binary without the plugin image having to run as a daemon
+	grpcPluginVolumeName        = "grpc-storage-plugin"
+	grpcPluginInitContainerName = "install-grpc-storage-plugin"
+
+	// grpcPluginInitMountPath is where the init container mounts the shared
+	// volume; it is kept apart from the /plugin source directory so the mount
+	// never hides the binary being copied
+	grpcPluginInitMountPath = "/grpc-storage-plugin"
+)
+
+// isGRPCPluginStorage returns true when the Jaeger instance is configured to use
+// the gRPC storage plugin, letting users run Jaeger against any storage backend
+// that ships a compatible plugin binary (ClickHouse, InfluxDB, etc.)
+func isGRPCPluginStorage(storageType string) bool {
+	return strings.EqualFold(storageType, storageTypeGRPCPlugin)
+}
+
+// grpcPluginArgs returns the --grpc-storage-plugin.* flags used by the collector
+// and query deployments to load the plugin binary
+func grpcPluginArgs(spec *v1.GRPCPluginSpec) []string {
+	if spec == nil {
+		return nil
+	}
+
+	args := []string{
+		"--grpc-storage-plugin.binary=" + spec.Binary,
+		"--grpc-storage-plugin.configuration-file=" + spec.ConfigurationFile,
+	}
+
+	if spec.TLS.Enabled {
+		args = append(args, "--grpc-storage-plugin.tls.enabled=true")
+		if spec.TLS.CA != "" {
+			args = append(args, "--grpc-storage-plugin.tls.ca="+spec.TLS.CA)
+		}
+	}
+
+	return args
+}
+
+// grpcPluginVolume is the emptyDir volume the init container copies the
+// plugin binary into, shared with the collector/query containers that exec it
+func grpcPluginVolume() corev1.Volume {
+	return corev1.Volume{
+		Name:         grpcPluginVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}
+}
+
+// grpcPluginVolumeMount mounts the shared emptyDir at the directory spec.Binary
+// lives in, so the main containers find the copied binary at spec.Binary
+func grpcPluginVolumeMount(spec *v1.GRPCPluginSpec) corev1.VolumeMount {
+	return corev1.VolumeMount{Name: grpcPluginVolumeName, MountPath: filepath.Dir(spec.Binary)}
+}
+
+// grpcPluginInitContainer copies the plugin binary out of the user-supplied
+// plugin image into the shared emptyDir, so the collector/query containers can
+// exec it without the plugin image having to ship a long-running process
+func grpcPluginInitContainer(spec *v1.GRPCPluginSpec) corev1.Container {
+	return corev1.Container{
+		Name:         grpcPluginInitContainerName,
+		Image:        spec.Image,
+		Command:      []string{"cp", "/plugin/plugin", filepath.Join(grpcPluginInitMountPath, filepath.Base(spec.Binary))},
+		VolumeMounts: []corev1.VolumeMount{{Name: grpcPluginVolumeName, MountPath: grpcPluginInitMountPath}},
+	}
+}
+
+// mountGRPCPluginBinary wires the shared volume, its mount and the init
+// container that populates it into a component's pod spec, skipping components
+// that already have it (e.g. a previous reconciliation already injected it)
+func mountGRPCPluginBinary(commonSpec *v1.JaegerCommonSpec, spec *v1.GRPCPluginSpec) {
+	if spec == nil || spec.Image == "" {
+		return
+	}
+
+	for _, existing := range commonSpec.Volumes {
+		if existing.Name == grpcPluginVolumeName {
+			return
+		}
+	}
+
+	commonSpec.Volumes = append(commonSpec.Volumes, grpcPluginVolume())
+	commonSpec.VolumeMounts = append(commonSpec.VolumeMounts, grpcPluginVolumeMount(spec))
+	commonSpec.InitContainers = append(commonSpec.InitContainers, grpcPluginInitContainer(spec))
+}
+
+// normalizeGRPCPlugin propagates the --grpc-storage-plugin.* flags to the
+// collector and query deployments, the two components that talk to storage,
+// and mounts the plugin binary into both via a shared init container so the
+// flags actually point at a binary that exists in the container
+func normalizeGRPCPlugin(jaeger *v1.Jaeger) {
+	if !isGRPCPluginStorage(jaeger.Spec.Storage.Type) {
+		return
+	}
+
+	spec := jaeger.Spec.Storage.GRPCPlugin
+	args := grpcPluginArgs(spec)
+	jaeger.Spec.Collector.Options = mergeFlags(jaeger.Spec.Collector.Options, args)
+	jaeger.Spec.Query.Options = mergeFlags(jaeger.Spec.Query.Options, args)
+
+	mountGRPCPluginBinary(&jaeger.Spec.Collector.JaegerCommonSpec, spec)
+	mountGRPCPluginBinary(&jaeger.Spec.Query.JaegerCommonSpec, spec)
+}
@@ -0,0 +1,79 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestGRPCPluginArgsWithoutTLS(t *testing.T) {
+	spec := &v1.GRPCPluginSpec{Binary: "/plugin/plugin", ConfigurationFile: "/plugin/config.yaml"}
+
+	args := grpcPluginArgs(spec)
+
+	assert.Equal(t, []string{
+		"--grpc-storage-plugin.binary=/plugin/plugin",
+		"--grpc-storage-plugin.configuration-file=/plugin/config.yaml",
+	}, args)
+}
+
+func TestGRPCPluginArgsWithTLSEnabled(t *testing.T) {
+	spec := &v1.GRPCPluginSpec{Binary: "/plugin/plugin"}
+	spec.TLS.Enabled = true
+	spec.TLS.CA = "/plugin/ca.crt"
+
+	args := grpcPluginArgs(spec)
+
+	assert.Contains(t, args, "--grpc-storage-plugin.tls.enabled=true")
+	assert.Contains(t, args, "--grpc-storage-plugin.tls.ca=/plugin/ca.crt")
+}
+
+func TestGRPCPluginArgsWithTLSEnabledWithoutCA(t *testing.T) {
+	spec := &v1.GRPCPluginSpec{Binary: "/plugin/plugin"}
+	spec.TLS.Enabled = true
+
+	args := grpcPluginArgs(spec)
+
+	assert.Contains(t, args, "--grpc-storage-plugin.tls.enabled=true")
+	for _, arg := range args {
+		assert.NotContains(t, arg, "tls.ca")
+	}
+}
+
+func TestGRPCPluginArgsNilSpec(t *testing.T) {
+	assert.Nil(t, grpcPluginArgs(nil))
+}
+
+func TestMountGRPCPluginBinary(t *testing.T) {
+	commonSpec := &v1.JaegerCommonSpec{}
+	spec := &v1.GRPCPluginSpec{Binary: "/plugin/plugin", Image: "example.com/clickhouse-plugin:1.0"}
+
+	mountGRPCPluginBinary(commonSpec, spec)
+
+	assert.Len(t, commonSpec.Volumes, 1)
+	assert.Len(t, commonSpec.VolumeMounts, 1)
+	assert.Len(t, commonSpec.InitContainers, 1)
+	assert.Equal(t, "/plugin", commonSpec.VolumeMounts[0].MountPath)
+	assert.Equal(t, spec.Image, commonSpec.InitContainers[0].Image)
+}
+
+func TestMountGRPCPluginBinaryIsIdempotent(t *testing.T) {
+	commonSpec := &v1.JaegerCommonSpec{}
+	spec := &v1.GRPCPluginSpec{Binary: "/plugin/plugin", Image: "example.com/clickhouse-plugin:1.0"}
+
+	mountGRPCPluginBinary(commonSpec, spec)
+	mountGRPCPluginBinary(commonSpec, spec)
+
+	assert.Len(t, commonSpec.Volumes, 1)
+}
+
+func TestMountGRPCPluginBinarySkipsWithoutImage(t *testing.T) {
+	commonSpec := &v1.JaegerCommonSpec{}
+	spec := &v1.GRPCPluginSpec{Binary: "/plugin/plugin"}
+
+	mountGRPCPluginBinary(commonSpec, spec)
+
+	assert.Empty(t, commonSpec.Volumes)
+}
@@ -0,0 +1,71 @@
+package strategy
+
+import (
+	"strings"
+
+	esv1 "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1"
+)
+
+const storageTypeOpenSearch = "opensearch"
+
+// isOpenSearch returns true when the storage type is the OpenSearch distribution,
+// as opposed to upstream Elasticsearch
+func isOpenSearch(storageType string) bool {
+	return strings.EqualFold(storageType, storageTypeOpenSearch)
+}
+
+// isElasticsearchFamily returns true for either of the two distributions that
+// share the Elasticsearch query/index APIs
+func isElasticsearchFamily(storageType string) bool {
+	return strings.EqualFold(storageType, "elasticsearch") || isOpenSearch(storageType)
+}
+
+// esVersionFlag returns the `--es.version` flag used to tell the collector/query/
+// ingester which index API version to speak, which differs between OpenSearch
+// and upstream Elasticsearch
+func esVersionFlag(storageType string) string {
+	if isOpenSearch(storageType) {
+		return "--es.version=7"
+	}
+	return ""
+}
+
+// esManagedClusterImageKey returns the viper config key holding the default
+// image used when the operator provisions a managed cluster for the given
+// distribution, to be resolved the same way every other default image is:
+// util.ImageName(existing, key)
+func esManagedClusterImageKey(storageType string) string {
+	if isOpenSearch(storageType) {
+		return "jaeger-opensearch-image"
+	}
+	return "jaeger-elasticsearch-image"
+}
+
+// esManagedClusterKind returns the Kind of the custom resource the operator
+// provisions for a managed cluster of the given distribution: the
+// elastic-operator's "Elasticsearch" CRD, or the opensearch-k8s-operator's
+// "OpenSearchCluster" CRD. Callers thread this onto storage.ElasticsearchDeployment
+// so the object-building code knows which kind to emit
+func esManagedClusterKind(storageType string) string {
+	if isOpenSearch(storageType) {
+		return "OpenSearchCluster"
+	}
+	return "Elasticsearch"
+}
+
+// esRedundancyPolicyFor returns the distribution-specific redundancy policy for
+// a managed cluster with the given node count. The elastic-operator CRD spells
+// this "ZeroRedundancy"/"SingleRedundancy"; the opensearch-k8s-operator CRD
+// spells the same concept as lowercase "zero"/"single"
+func esRedundancyPolicyFor(storageType string, nodeCount int) esv1.RedundancyPolicyType {
+	if isOpenSearch(storageType) {
+		if nodeCount == 1 {
+			return esv1.RedundancyPolicyType("zero")
+		}
+		return esv1.RedundancyPolicyType("single")
+	}
+	if nodeCount == 1 {
+		return esv1.ZeroRedundancy
+	}
+	return esv1.SingleRedundancy
+}
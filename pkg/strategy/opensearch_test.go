@@ -0,0 +1,43 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1 "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1"
+)
+
+func TestEsVersionFlagOpenSearch(t *testing.T) {
+	assert.Equal(t, "--es.version=7", esVersionFlag("opensearch"))
+}
+
+func TestEsVersionFlagElasticsearch(t *testing.T) {
+	assert.Empty(t, esVersionFlag("elasticsearch"))
+}
+
+func TestEsManagedClusterImageKeyOpenSearch(t *testing.T) {
+	assert.Equal(t, "jaeger-opensearch-image", esManagedClusterImageKey("opensearch"))
+}
+
+func TestEsManagedClusterImageKeyElasticsearch(t *testing.T) {
+	assert.Equal(t, "jaeger-elasticsearch-image", esManagedClusterImageKey("elasticsearch"))
+}
+
+func TestEsManagedClusterKindOpenSearch(t *testing.T) {
+	assert.Equal(t, "OpenSearchCluster", esManagedClusterKind("opensearch"))
+}
+
+func TestEsManagedClusterKindElasticsearch(t *testing.T) {
+	assert.Equal(t, "Elasticsearch", esManagedClusterKind("elasticsearch"))
+}
+
+func TestEsRedundancyPolicyForElasticsearch(t *testing.T) {
+	assert.Equal(t, esv1.SingleRedundancy, esRedundancyPolicyFor("elasticsearch", 3))
+	assert.Equal(t, esv1.ZeroRedundancy, esRedundancyPolicyFor("elasticsearch", 1))
+}
+
+func TestEsRedundancyPolicyForOpenSearch(t *testing.T) {
+	assert.Equal(t, esv1.RedundancyPolicyType("single"), esRedundancyPolicyFor("opensearch", 3))
+	assert.Equal(t, esv1.RedundancyPolicyType("zero"), esRedundancyPolicyFor("opensearch", 1))
+}
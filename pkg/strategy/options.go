@@ -0,0 +1,32 @@
+package strategy
+
+import (
+	"strings"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+// mergeFlags merges the given `--key=value` flags into opts, without
+// overwriting any flag the user has already set explicitly
+func mergeFlags(opts v1.Options, flags []string) v1.Options {
+	if len(flags) == 0 {
+		return opts
+	}
+
+	merged := opts.Map()
+	if merged == nil {
+		merged = map[string]string{}
+	}
+
+	for _, flag := range flags {
+		key, value, found := strings.Cut(strings.TrimPrefix(flag, "--"), "=")
+		if !found {
+			continue
+		}
+		if _, ok := merged[key]; !ok {
+			merged[key] = value
+		}
+	}
+
+	return v1.NewOptions(merged)
+}
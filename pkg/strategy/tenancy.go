@@ -0,0 +1,116 @@
+package strategy
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/cronjob"
+)
+
+const defaultTenancyHeader = "x-tenant"
+
+// tenancyEnabled returns true when the user declared at least one tenant
+func tenancyEnabled(jaeger *v1.Jaeger) bool {
+	return len(jaeger.Spec.Tenancy.Tenants) > 0
+}
+
+// normalizeTenancy applies defaults to the tenancy spec and rejects combinations
+// that the operator cannot support, falling back to a disabled tenancy rather
+// than producing a broken deployment
+func normalizeTenancy(jaeger *v1.Jaeger) {
+	if !tenancyEnabled(jaeger) {
+		return
+	}
+
+	if !distributedStorage(jaeger.Spec.Storage.Type) {
+		jaeger.Logger().WithField("storage", jaeger.Spec.Storage.Type).
+			Warn("Multi-tenancy is not supported with memory/badger storage. Disabling tenancy")
+		jaeger.Spec.Tenancy.Tenants = nil
+		return
+	}
+
+	if jaeger.Spec.Tenancy.Header == "" {
+		jaeger.Spec.Tenancy.Header = defaultTenancyHeader
+	}
+
+	if jaeger.Spec.Tenancy.Default == "" {
+		jaeger.Spec.Tenancy.Default = jaeger.Spec.Tenancy.Tenants[0]
+	}
+
+	// propagate the --multi-tenancy.* flags to every component that talks to storage
+	args := tenancyArgs(jaeger)
+	jaeger.Spec.Collector.Options = mergeFlags(jaeger.Spec.Collector.Options, args)
+	jaeger.Spec.Query.Options = mergeFlags(jaeger.Spec.Query.Options, args)
+	jaeger.Spec.Agent.Options = mergeFlags(jaeger.Spec.Agent.Options, args)
+}
+
+// tenancyArgs returns the --multi-tenancy.* flags shared by collector, query and
+// agent deployments when tenancy is enabled
+func tenancyArgs(jaeger *v1.Jaeger) []string {
+	if !tenancyEnabled(jaeger) {
+		return nil
+	}
+
+	return []string{
+		"--multi-tenancy.enabled=true",
+		fmt.Sprintf("--multi-tenancy.header=%s", jaeger.Spec.Tenancy.Header),
+	}
+}
+
+// tenantIndexPrefix returns the per-tenant `es.index-prefix` value, combining
+// any user-supplied prefix with the tenant name so each tenant's indices stay
+// isolated from one another
+func tenantIndexPrefix(basePrefix, tenant string) string {
+	if basePrefix == "" {
+		return tenant
+	}
+	return fmt.Sprintf("%s-%s", basePrefix, tenant)
+}
+
+// tenantCronJobNames returns the per-tenant cronjob names so that spark-dependencies,
+// es-index-cleaner and es-rollover each get their own scheduled maintenance job,
+// named after the base job and the tenant
+func tenantCronJobNames(baseName string, jaeger *v1.Jaeger) []string {
+	if !tenancyEnabled(jaeger) {
+		return []string{baseName}
+	}
+
+	names := make([]string, 0, len(jaeger.Spec.Tenancy.Tenants))
+	for _, tenant := range jaeger.Spec.Tenancy.Tenants {
+		names = append(names, fmt.Sprintf("%s-%s", baseName, strings.ToLower(tenant)))
+	}
+	return names
+}
+
+// tenantCronJobs builds the per-tenant spark-dependencies, es-index-cleaner and
+// es-rollover cronjobs, one set per tenant, each targeting that tenant's own
+// index prefix. This is how "each tenant gets its own scheduled maintenance" is
+// actually produced
+func tenantCronJobs(jaeger *v1.Jaeger) []cronjob.CronJob {
+	if !tenancyEnabled(jaeger) || !distributedStorage(jaeger.Spec.Storage.Type) || !isElasticsearchFamily(jaeger.Spec.Storage.Type) {
+		return nil
+	}
+
+	basePrefix := jaeger.Spec.Storage.Options.Map()["es.index-prefix"]
+
+	jobs := make([]cronjob.CronJob, 0, len(jaeger.Spec.Tenancy.Tenants)*3)
+	for i, tenant := range jaeger.Spec.Tenancy.Tenants {
+		prefix := tenantIndexPrefix(basePrefix, tenant)
+		sparkDependenciesName := tenantCronJobNames("jaeger-spark-dependencies", jaeger)[i]
+		indexCleanerName := tenantCronJobNames("jaeger-es-index-cleaner", jaeger)[i]
+		rolloverName := tenantCronJobNames("jaeger-es-rollover", jaeger)[i]
+
+		if jaeger.Spec.Storage.Dependencies.Enabled != nil && *jaeger.Spec.Storage.Dependencies.Enabled {
+			jobs = append(jobs, cronjob.CreateSparkDependenciesForTenant(jaeger, sparkDependenciesName, prefix))
+		}
+		if jaeger.Spec.Storage.EsIndexCleaner.Enabled != nil && *jaeger.Spec.Storage.EsIndexCleaner.Enabled {
+			jobs = append(jobs, cronjob.CreateEsIndexCleanerForTenant(jaeger, indexCleanerName, prefix))
+		}
+		if jaeger.Spec.Storage.EsRollover.Schedule != "" {
+			jobs = append(jobs, cronjob.CreateEsRolloverForTenant(jaeger, rolloverName, prefix))
+		}
+	}
+
+	return jobs
+}
@@ -0,0 +1,51 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestTenantIndexPrefix(t *testing.T) {
+	assert.Equal(t, "acme", tenantIndexPrefix("", "acme"))
+	assert.Equal(t, "jaeger-acme", tenantIndexPrefix("jaeger", "acme"))
+}
+
+func TestTenantCronJobNames(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Tenancy.Tenants = []string{"acme", "contoso"}
+
+	names := tenantCronJobNames("jaeger-es-rollover", jaeger)
+	assert.Equal(t, []string{"jaeger-es-rollover-acme", "jaeger-es-rollover-contoso"}, names)
+}
+
+func TestTenantCronJobNamesWithoutTenancy(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+
+	names := tenantCronJobNames("jaeger-es-rollover", jaeger)
+	assert.Equal(t, []string{"jaeger-es-rollover"}, names)
+}
+
+func TestTenantCronJobsIncludesSparkDependenciesWhenEnabled(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Storage.Type = "elasticsearch"
+	jaeger.Spec.Tenancy.Tenants = []string{"acme"}
+	enabled := true
+	jaeger.Spec.Storage.Dependencies.Enabled = &enabled
+
+	jobs := tenantCronJobs(jaeger)
+
+	assert.Len(t, jobs, 1)
+}
+
+func TestTenantCronJobsSkipsSparkDependenciesWhenDisabled(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Storage.Type = "elasticsearch"
+	jaeger.Spec.Tenancy.Tenants = []string{"acme"}
+
+	jobs := tenantCronJobs(jaeger)
+
+	assert.Empty(t, jobs)
+}
@@ -0,0 +1,122 @@
+package strategy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+// UIMenuItem represents a single link rendered under a UIMenuGroup in the
+// Jaeger UI's top navigation menu
+type UIMenuItem struct {
+	Label        string       `json:"label" mapstructure:"label"`
+	URL          string       `json:"url,omitempty" mapstructure:"url"`
+	AnchorTarget string       `json:"anchorTarget,omitempty" mapstructure:"anchorTarget"`
+	Items        []UIMenuItem `json:"items,omitempty" mapstructure:"items"`
+}
+
+// UIMenuGroup represents one top-level entry of the Jaeger UI menu
+type UIMenuGroup = UIMenuItem
+
+// docsMenuGroup builds the "About" menu group linking to the documentation
+func docsMenuGroup(docURL string) UIMenuGroup {
+	return UIMenuGroup{
+		Label: "About",
+		Items: []UIMenuItem{
+			{Label: "Documentation", URL: docURL},
+		},
+	}
+}
+
+// logOutMenuGroup builds the "Log Out" menu group injected when OAuth Proxy
+// is in front of the UI
+func logOutMenuGroup() UIMenuGroup {
+	return UIMenuGroup{
+		Label:        "Log Out",
+		URL:          "/oauth/sign_in",
+		AnchorTarget: "_self",
+	}
+}
+
+// hasMenuGroup returns true when the menu already has a top-level group with
+// the given label
+func hasMenuGroup(menu []UIMenuGroup, label string) bool {
+	for _, group := range menu {
+		if group.Label == label {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeUIMenu reads the user-supplied `menu` UI option, if any, into a typed
+// slice of UIMenuGroup. Invalid user input is rejected with an error rather than
+// silently dropped, so the caller can leave it untouched instead of producing a
+// broken menu
+func decodeUIMenu(uiOpts map[string]interface{}) ([]UIMenuGroup, error) {
+	raw, ok := uiOpts["menu"]
+	if !ok {
+		return nil, nil
+	}
+
+	var menu []UIMenuGroup
+	if err := mapstructure.Decode(raw, &menu); err != nil {
+		return nil, fmt.Errorf("invalid 'menu' UI option: %w", err)
+	}
+
+	return menu, nil
+}
+
+// encodeUIMenu converts the typed menu back into the map[string]interface{}
+// representation expected by the FreeForm UI options
+func encodeUIMenu(menu []UIMenuGroup) (interface{}, error) {
+	b, err := json.Marshal(menu)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func enableLogOut(uiOpts map[string]interface{}, spec *v1.JaegerSpec) {
+	if (spec.Ingress.Enabled != nil && *spec.Ingress.Enabled == false) ||
+		spec.Ingress.Security != v1.IngressSecurityOAuthProxy {
+		return
+	}
+
+	menu, err := decodeUIMenu(uiOpts)
+	if err != nil {
+		// the user's menu is malformed - leave it untouched rather than guessing
+		log.WithField("error", err).Warn("Could not parse the UI menu option, leaving it as-is")
+		return
+	}
+
+	if hasMenuGroup(menu, "Log Out") {
+		// already there, e.g. a previous reconciliation already injected it
+		return
+	}
+
+	if menu == nil {
+		// no user-supplied menu: ship the same About/Log Out menu as before
+		menu = append(menu, docsMenuGroup(viper.GetString("documentation-url")))
+	}
+	menu = append(menu, logOutMenuGroup())
+
+	encoded, err := encodeUIMenu(menu)
+	if err != nil {
+		log.WithField("error", err).Warn("Could not build the UI Log Out menu")
+		return
+	}
+
+	uiOpts["menu"] = encoded
+}
@@ -0,0 +1,92 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestEnableLogOutInjectsMenu(t *testing.T) {
+	uiOpts := map[string]interface{}{}
+	enabled := true
+	spec := &v1.JaegerSpec{
+		Ingress: v1.JaegerIngressSpec{Enabled: &enabled, Security: v1.IngressSecurityOAuthProxy},
+	}
+
+	enableLogOut(uiOpts, spec)
+
+	menu, ok := uiOpts["menu"].([]interface{})
+	assert.True(t, ok)
+	assert.Len(t, menu, 2)
+}
+
+func TestEnableLogOutMergesUserMenu(t *testing.T) {
+	uiOpts := map[string]interface{}{
+		"menu": []interface{}{
+			map[string]interface{}{"label": "Support", "url": "https://example.com"},
+		},
+	}
+	enabled := true
+	spec := &v1.JaegerSpec{
+		Ingress: v1.JaegerIngressSpec{Enabled: &enabled, Security: v1.IngressSecurityOAuthProxy},
+	}
+
+	enableLogOut(uiOpts, spec)
+
+	menu, ok := uiOpts["menu"].([]interface{})
+	assert.True(t, ok)
+	assert.Len(t, menu, 2)
+	assert.Equal(t, "Support", menu[0].(map[string]interface{})["label"])
+	assert.Equal(t, "Log Out", menu[1].(map[string]interface{})["label"])
+}
+
+func TestEnableLogOutDoesNotDuplicateLogOut(t *testing.T) {
+	uiOpts := map[string]interface{}{
+		"menu": []interface{}{
+			map[string]interface{}{"label": "Support", "url": "https://example.com"},
+			map[string]interface{}{"label": "Log Out", "url": "/oauth/sign_in", "anchorTarget": "_self"},
+		},
+	}
+	enabled := true
+	spec := &v1.JaegerSpec{
+		Ingress: v1.JaegerIngressSpec{Enabled: &enabled, Security: v1.IngressSecurityOAuthProxy},
+	}
+
+	enableLogOut(uiOpts, spec)
+
+	menu, ok := uiOpts["menu"].([]interface{})
+	assert.True(t, ok)
+	assert.Len(t, menu, 2)
+}
+
+func TestEnableLogOutRejectsInvalidMenu(t *testing.T) {
+	uiOpts := map[string]interface{}{"menu": "not-a-menu"}
+	enabled := true
+	spec := &v1.JaegerSpec{
+		Ingress: v1.JaegerIngressSpec{Enabled: &enabled, Security: v1.IngressSecurityOAuthProxy},
+	}
+
+	enableLogOut(uiOpts, spec)
+
+	// invalid input is left untouched rather than silently replaced
+	assert.Equal(t, "not-a-menu", uiOpts["menu"])
+}
+
+func TestEnableLogOutSkipsWhenNotOAuthProxy(t *testing.T) {
+	uiOpts := map[string]interface{}{}
+	spec := &v1.JaegerSpec{
+		Ingress: v1.JaegerIngressSpec{Security: v1.IngressSecurityNoneExplicit},
+	}
+
+	enableLogOut(uiOpts, spec)
+
+	_, ok := uiOpts["menu"]
+	assert.False(t, ok)
+}
+
+func TestDecodeUIMenuRejectsInvalidInput(t *testing.T) {
+	_, err := decodeUIMenu(map[string]interface{}{"menu": 42})
+	assert.Error(t, err)
+}
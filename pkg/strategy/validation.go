@@ -0,0 +1,119 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/storage"
+	esv1 "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1"
+)
+
+// FieldError describes a validation failure tied to a specific field path.
+// Unlike a log line, this is meant to be surfaced back to the user, e.g. by
+// a validating webhook rejecting the request at `kubectl apply` time
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) String() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// FieldWarning describes a non-fatal normalization that was applied, such as
+// falling back to a default value
+type FieldWarning struct {
+	Field   string
+	Message string
+}
+
+func (w FieldWarning) String() string {
+	return fmt.Sprintf("%s: %s", w.Field, w.Message)
+}
+
+// ValidationResult accumulates the errors and warnings produced while
+// validating/normalizing a Jaeger CR
+type ValidationResult struct {
+	Errors   []FieldError
+	Warnings []FieldWarning
+}
+
+// AddError records a field-level validation failure
+func (r *ValidationResult) AddError(field, format string, args ...interface{}) {
+	r.Errors = append(r.Errors, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// AddWarning records a normalization that was applied automatically
+func (r *ValidationResult) AddWarning(field, format string, args ...interface{}) {
+	r.Warnings = append(r.Warnings, FieldWarning{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// HasErrors returns true when at least one validation error was recorded
+func (r *ValidationResult) HasErrors() bool {
+	return r != nil && len(r.Errors) > 0
+}
+
+// Error implements the error interface so a ValidationResult can be returned
+// directly from an admission handler when it has errors
+func (r *ValidationResult) Error() string {
+	msgs := make([]string, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		msgs = append(msgs, e.String())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Validate runs the same normalization code path used by the reconciler against
+// a copy of the given Jaeger instance and returns the resulting ValidationResult,
+// without mutating the object passed in. This is the entry point used by the
+// validating webhook to reject invalid CRs at admission time
+func Validate(ctx context.Context, jaeger *v1.Jaeger) *ValidationResult {
+	copied := jaeger.DeepCopy()
+	return normalize(ctx, copied)
+}
+
+// validateStorageAndStrategy records the admission-time errors for
+// combinations that the operator used to silently rewrite, such as a
+// production strategy paired with memory/badger storage or an unknown
+// storage type.
+//
+// This runs before defaulting, so every check here must only fire on an
+// explicit, invalid value - never on a field the user simply omitted, as
+// omitted fields are defaulted further down in normalize() and are not an
+// error. An unset elasticsearch.nodeCount (0) falls into that "omitted"
+// bucket. An omitted spec.storage.type falls into it too, except when paired
+// with an explicit production/streaming strategy or with multi-tenancy:
+// normalize() would default storage to memory and then silently rewrite the
+// strategy back to allInOne, or clear out the tenants, which is exactly the
+// "operator quietly rewrites user intent" behavior this validation exists to
+// close, so those combinations are rejected instead.
+func validateStorageAndStrategy(jaeger *v1.Jaeger, result *ValidationResult) {
+	storageType := jaeger.Spec.Storage.Type
+
+	if storageType != "" && unknownStorage(storageType) {
+		result.AddError("spec.storage.type", "unknown storage type %q, known options: %v", storageType, storage.ValidTypes())
+	}
+
+	requiresDistributedStorage := jaeger.Spec.Strategy == v1.DeploymentStrategyProduction || jaeger.Spec.Strategy == v1.DeploymentStrategyStreaming
+	if requiresDistributedStorage && storageType == "" {
+		result.AddError("spec.storage.type", "strategy %q requires an explicit distributed storage type, but spec.storage.type was not set", jaeger.Spec.Strategy)
+	} else if requiresDistributedStorage && !distributedStorage(storageType) {
+		result.AddError("spec.strategy", "strategy %q requires a distributed storage, but storage is %q", jaeger.Spec.Strategy, storageType)
+	}
+
+	// nodeCount==1 paired with SingleRedundancy is the real contradiction: a
+	// single node cannot hold a second replica. nodeCount==0 just means
+	// "unset" (normalizeElasticsearch defaults it to 3) and is not an error.
+	es := jaeger.Spec.Storage.Elasticsearch
+	if es.NodeCount == 1 && es.RedundancyPolicy == esv1.SingleRedundancy {
+		result.AddError("spec.storage.elasticsearch.redundancyPolicy", "redundancyPolicy=%s requires nodeCount > 1, got 1", esv1.SingleRedundancy)
+	}
+
+	if tenancyEnabled(jaeger) && storageType == "" {
+		result.AddError("spec.tenancy", "multi-tenancy requires an explicit distributed storage type, but spec.storage.type was not set")
+	} else if tenancyEnabled(jaeger) && !distributedStorage(storageType) {
+		result.AddError("spec.tenancy", "multi-tenancy cannot be combined with memory/badger storage")
+	}
+}
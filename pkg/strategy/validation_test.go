@@ -0,0 +1,104 @@
+package strategy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	esv1 "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1"
+)
+
+func TestValidateAllowsOmittedStorageType(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+
+	result := Validate(context.Background(), jaeger)
+
+	assert.False(t, result.HasErrors())
+}
+
+func TestValidateAllowsOmittedNodeCount(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Storage.Type = "elasticsearch"
+	jaeger.Spec.Storage.Elasticsearch.RedundancyPolicy = esv1.SingleRedundancy
+
+	result := Validate(context.Background(), jaeger)
+
+	assert.False(t, result.HasErrors())
+}
+
+func TestValidateRejectsUnknownStorageType(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Storage.Type = "not-a-real-storage"
+
+	result := Validate(context.Background(), jaeger)
+
+	assert.True(t, result.HasErrors())
+}
+
+func TestValidateRejectsSingleNodeWithSingleRedundancy(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Storage.Type = "elasticsearch"
+	jaeger.Spec.Storage.Elasticsearch.NodeCount = 1
+	jaeger.Spec.Storage.Elasticsearch.RedundancyPolicy = esv1.SingleRedundancy
+
+	result := Validate(context.Background(), jaeger)
+
+	assert.True(t, result.HasErrors())
+}
+
+func TestValidateRejectsProductionWithMemoryStorage(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Storage.Type = "memory"
+	jaeger.Spec.Strategy = v1.DeploymentStrategyProduction
+
+	result := Validate(context.Background(), jaeger)
+
+	assert.True(t, result.HasErrors())
+}
+
+func TestValidateRejectsProductionWithOmittedStorageType(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Strategy = v1.DeploymentStrategyProduction
+
+	result := Validate(context.Background(), jaeger)
+
+	assert.True(t, result.HasErrors())
+}
+
+func TestValidateRejectsStreamingWithOmittedStorageType(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+
+	result := Validate(context.Background(), jaeger)
+
+	assert.True(t, result.HasErrors())
+}
+
+func TestValidateRejectsTenancyWithOmittedStorageType(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Tenancy.Tenants = []string{"acme"}
+
+	result := Validate(context.Background(), jaeger)
+
+	assert.True(t, result.HasErrors())
+}
+
+func TestValidateRejectsTenancyWithMemoryStorage(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Storage.Type = "memory"
+	jaeger.Spec.Tenancy.Tenants = []string{"acme"}
+
+	result := Validate(context.Background(), jaeger)
+
+	assert.True(t, result.HasErrors())
+}
+
+func TestValidateDoesNotMutateTheOriginal(t *testing.T) {
+	jaeger := &v1.Jaeger{}
+
+	Validate(context.Background(), jaeger)
+
+	assert.Empty(t, jaeger.Spec.Storage.Type)
+}
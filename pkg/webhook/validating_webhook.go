@@ -0,0 +1,64 @@
+// Package webhook implements the admission webhooks registered by the operator.
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
+)
+
+// ValidatingWebhookPath is the path the ValidatingWebhookConfiguration points
+// the API server at for Jaeger admission review requests
+const ValidatingWebhookPath = "/validate-jaegertracing-io-v1-jaeger"
+
+// JaegerValidator rejects Jaeger CRs that normalize() would otherwise silently
+// rewrite, such as a production strategy backed by memory storage. It runs the
+// same code path used by the reconciler, so admission-time behavior can never
+// drift from what actually gets deployed
+type JaegerValidator struct {
+	decoder *admission.Decoder
+}
+
+// NewJaegerValidator creates a new JaegerValidator
+func NewJaegerValidator(decoder *admission.Decoder) *JaegerValidator {
+	return &JaegerValidator{decoder: decoder}
+}
+
+// Handle implements admission.Handler
+func (v *JaegerValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	jaeger := &v1.Jaeger{}
+	if err := v.decoder.Decode(req, jaeger); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	result := strategy.Validate(ctx, jaeger)
+	if result.HasErrors() {
+		return admission.Denied(result.Error())
+	}
+
+	return admission.Allowed("")
+}
+
+// InjectDecoder injects the admission decoder, as required by controller-runtime
+func (v *JaegerValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+var _ admission.Handler = (*JaegerValidator)(nil)
+
+// RegisterWithManager registers the JaegerValidator with the manager's webhook
+// server at ValidatingWebhookPath. The matching ValidatingWebhookConfiguration
+// (deploy/webhook/validating-webhook-configuration.yaml) points the API server
+// at this same path, so the two must be kept in sync
+func RegisterWithManager(mgr manager.Manager) {
+	mgr.GetWebhookServer().Register(ValidatingWebhookPath, &webhook.Admission{
+		Handler: NewJaegerValidator(nil),
+	})
+}
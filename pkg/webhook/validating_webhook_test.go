@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func newDecoder(t *testing.T) *admission.Decoder {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(scheme))
+
+	decoder, err := admission.NewDecoder(scheme)
+	require.NoError(t, err)
+	return decoder
+}
+
+func requestFor(t *testing.T, jaeger *v1.Jaeger) admission.Request {
+	raw, err := json.Marshal(jaeger)
+	require.NoError(t, err)
+
+	return admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Object: runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func TestJaegerValidatorAllowsValidCR(t *testing.T) {
+	v := NewJaegerValidator(newDecoder(t))
+
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Storage.Type = "elasticsearch"
+	jaeger.Spec.Strategy = v1.DeploymentStrategyProduction
+
+	resp := v.Handle(context.Background(), requestFor(t, jaeger))
+
+	assert.True(t, resp.Allowed)
+}
+
+func TestJaegerValidatorRejectsInvalidCR(t *testing.T) {
+	v := NewJaegerValidator(newDecoder(t))
+
+	jaeger := &v1.Jaeger{}
+	jaeger.Spec.Storage.Type = "memory"
+	jaeger.Spec.Strategy = v1.DeploymentStrategyProduction
+
+	resp := v.Handle(context.Background(), requestFor(t, jaeger))
+
+	assert.False(t, resp.Allowed)
+	assert.NotEmpty(t, resp.Result.Message)
+}